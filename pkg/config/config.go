@@ -0,0 +1,74 @@
+// Package config provides a layered configuration loader. Values are
+// merged from a defaults struct, one or more files (format detected by
+// extension: .json, .yaml/.yml, .toml), and environment variables, in
+// that precedence order — later providers win. It replaces the old
+// internal.Config[T] stub, which hard-coded a JSON literal and silently
+// dropped unmarshal errors.
+package config
+
+import (
+	"context"
+	"fmt"
+)
+
+// Validator is implemented by config types that want to reject invalid
+// values after all providers have been merged.
+type Validator interface {
+	Validate() error
+}
+
+// Loader builds a *T by merging a defaults value with zero or more file
+// providers and an optional environment variable overlay.
+type Loader[T any] struct {
+	defaults  T
+	files     []string
+	envPrefix string
+}
+
+// New creates a Loader seeded with defaults. Use WithFile and WithEnvPrefix
+// to add providers before calling Load or Watch.
+func New[T any](defaults T) *Loader[T] {
+	return &Loader[T]{defaults: defaults}
+}
+
+// WithFile adds a file provider. Files are applied in the order added,
+// each overriding fields set by providers before it. The format is
+// detected from path's extension (.json, .yaml, .yml, .toml).
+func (l *Loader[T]) WithFile(path string) *Loader[T] {
+	l.files = append(l.files, path)
+	return l
+}
+
+// WithEnvPrefix enables the environment variable overlay, applied after
+// all files, using prefix (e.g. "ENGINE_NOVA" reads ENGINE_NOVA_BASE_URL
+// for a field tagged `env:"BASE_URL"`).
+func (l *Loader[T]) WithEnvPrefix(prefix string) *Loader[T] {
+	l.envPrefix = prefix
+	return l
+}
+
+// Load merges all configured providers in precedence order and returns the
+// resulting value. Errors are wrapped to identify which provider failed.
+func (l *Loader[T]) Load(ctx context.Context) (*T, error) {
+	value := l.defaults
+
+	for _, path := range l.files {
+		if err := mergeFile(&value, path); err != nil {
+			return nil, fmt.Errorf("config: load %s: %w", path, err)
+		}
+	}
+
+	if l.envPrefix != "" {
+		if err := mergeEnv(&value, l.envPrefix); err != nil {
+			return nil, fmt.Errorf("config: apply env overrides (prefix %s): %w", l.envPrefix, err)
+		}
+	}
+
+	if v, ok := any(&value).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("config: validate: %w", err)
+		}
+	}
+
+	return &value, nil
+}
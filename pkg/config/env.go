@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// envName returns the environment variable suffix a struct field
+// contributes: its `env` tag if present, otherwise its upper-cased
+// fieldName.
+func envName(f reflect.StructField) string {
+	if tag := f.Tag.Get("env"); tag != "" && tag != "-" {
+		return tag
+	}
+	return strings.ToUpper(fieldName(f))
+}
+
+// mergeEnv overrides fields of the struct pointed to by value from
+// environment variables named "<prefix>_<envName>", recursing into nested
+// structs with the field's own envName appended to the prefix.
+func mergeEnv(value any, prefix string) error {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("apply env: destination must be a pointer to struct, got %T", value)
+	}
+	return mergeEnvStruct(rv.Elem(), prefix)
+}
+
+func mergeEnvStruct(sv reflect.Value, prefix string) error {
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		fv := sv.Field(i)
+		name := prefix + "_" + envName(f)
+
+		if fv.Kind() == reflect.Struct {
+			if err := mergeEnvStruct(fv, name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String {
+			fv.Set(reflect.ValueOf(strings.Split(raw, ",")))
+			continue
+		}
+		if err := setScalar(fv, raw); err != nil {
+			return fmt.Errorf("env %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mergeFile reads path, decodes it according to its extension, and merges
+// the result onto *value, overriding only the fields present in the file.
+func mergeFile(value any, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		if err := json.Unmarshal(data, value); err != nil {
+			return fmt.Errorf("parse json: %w", err)
+		}
+		return nil
+	case ".yaml", ".yml":
+		m, err := parseYAML(data)
+		if err != nil {
+			return fmt.Errorf("parse yaml: %w", err)
+		}
+		return applyMap(value, m)
+	case ".toml":
+		m, err := parseTOML(data)
+		if err != nil {
+			return fmt.Errorf("parse toml: %w", err)
+		}
+		return applyMap(value, m)
+	default:
+		return fmt.Errorf("unsupported config file extension %q", ext)
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseYAMLBlockList(t *testing.T) {
+	data := []byte("name: engine\nscopes:\n  - read\n  - write\n")
+
+	got, err := parseYAML(data)
+	if err != nil {
+		t.Fatalf("parseYAML: %v", err)
+	}
+
+	want := map[string]any{
+		"name":   "engine",
+		"scopes": []any{"read", "write"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseYAML(%q) = %#v, want %#v", data, got, want)
+	}
+}
+
+func TestParseYAMLFourSpaceIndent(t *testing.T) {
+	data := []byte("nova:\n    base_url: https://example.com\n    cell: cell1\n")
+
+	got, err := parseYAML(data)
+	if err != nil {
+		t.Fatalf("parseYAML: %v", err)
+	}
+
+	want := map[string]any{
+		"nova": map[string]any{
+			"base_url": "https://example.com",
+			"cell":     "cell1",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseYAML(%q) = %#v, want %#v", data, got, want)
+	}
+}
+
+func TestParseTOMLSingleQuotedString(t *testing.T) {
+	data := []byte("name = 'engine'\n")
+
+	got, err := parseTOML(data)
+	if err != nil {
+		t.Fatalf("parseTOML: %v", err)
+	}
+
+	want := map[string]any{"name": "engine"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTOML(%q) = %#v, want %#v", data, got, want)
+	}
+}
+
+func TestParseTOMLArrayOfTables(t *testing.T) {
+	data := []byte("[[cell]]\nname = 'cell1'\n\n[[cell]]\nname = 'cell2'\n")
+
+	got, err := parseTOML(data)
+	if err != nil {
+		t.Fatalf("parseTOML: %v", err)
+	}
+
+	want := map[string]any{
+		"cell": []any{
+			map[string]any{"name": "cell1"},
+			map[string]any{"name": "cell2"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTOML(%q) = %#v, want %#v", data, got, want)
+	}
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// fieldName returns the key a struct field is addressed by when merging
+// map-shaped data (from YAML/TOML) or environment variables: its `json`
+// tag name if present, falling back to the field name itself.
+func fieldName(f reflect.StructField) string {
+	if tag := f.Tag.Get("json"); tag != "" {
+		name := strings.Split(tag, ",")[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+// applyMap sets the exported fields of the struct pointed to by value from
+// a nested map[string]any (as produced by parseYAML/parseTOML), matching
+// keys case-insensitively against each field's fieldName.
+func applyMap(value any, m map[string]any) error {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("apply map: destination must be a pointer to struct, got %T", value)
+	}
+	return applyMapToStruct(rv.Elem(), m)
+}
+
+func applyMapToStruct(sv reflect.Value, m map[string]any) error {
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+		name := fieldName(f)
+		raw, ok := lookupCaseInsensitive(m, name)
+		if !ok {
+			continue
+		}
+
+		fv := sv.Field(i)
+		if fv.Kind() == reflect.Struct {
+			nested, ok := raw.(map[string]any)
+			if !ok {
+				return fmt.Errorf("field %s: expected a nested table, got %T", f.Name, raw)
+			}
+			if err := applyMapToStruct(fv, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := setScalar(fv, raw); err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func lookupCaseInsensitive(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// setScalar assigns raw (a string, bool, float64, or []any from a parsed
+// file, or a string from an env var) onto fv, converting as needed.
+func setScalar(fv reflect.Value, raw any) error {
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(fmt.Sprint(raw))
+	case reflect.Bool:
+		b, err := toBool(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := toInt64(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := toFloat64(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice element type %s", fv.Type().Elem())
+		}
+		items, ok := raw.([]any)
+		if !ok {
+			return fmt.Errorf("expected a list, got %T", raw)
+		}
+		out := make([]string, len(items))
+		for i, item := range items {
+			out[i] = fmt.Sprint(item)
+		}
+		fv.Set(reflect.ValueOf(out))
+	default:
+		return fmt.Errorf("unsupported field kind %s", fv.Kind())
+	}
+	return nil
+}
+
+func toBool(raw any) (bool, error) {
+	switch v := raw.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	default:
+		return false, fmt.Errorf("cannot convert %T to bool", raw)
+	}
+}
+
+func toInt64(raw any) (int64, error) {
+	switch v := raw.(type) {
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	default:
+		return 0, fmt.Errorf("cannot convert %T to int", raw)
+	}
+}
+
+func toFloat64(raw any) (float64, error) {
+	switch v := raw.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("cannot convert %T to float64", raw)
+	}
+}
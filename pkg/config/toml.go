@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseTOML parses the subset of TOML this package's config files actually
+// use: top-level and "[section]"/"[section.sub]" tables, "[[section]]"
+// arrays of tables, "key = value" pairs, and scalar/inline-array values
+// including single-quoted literal strings. It does not attempt to support
+// multi-line strings or dates.
+func parseTOML(data []byte) (map[string]any, error) {
+	root := map[string]any{}
+	table := root
+
+	for n, raw := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]") {
+			path := strings.Split(strings.TrimSuffix(strings.TrimPrefix(line, "[["), "]]"), ".")
+			parent := root
+			for _, segment := range path[:len(path)-1] {
+				segment = strings.TrimSpace(segment)
+				next, ok := parent[segment].(map[string]any)
+				if !ok {
+					next = map[string]any{}
+					parent[segment] = next
+				}
+				parent = next
+			}
+
+			last := strings.TrimSpace(path[len(path)-1])
+			entries, _ := parent[last].([]any)
+			newTable := map[string]any{}
+			parent[last] = append(entries, newTable)
+			table = newTable
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			path := strings.Split(strings.TrimSuffix(strings.TrimPrefix(line, "["), "]"), ".")
+			table = root
+			for _, segment := range path {
+				segment = strings.TrimSpace(segment)
+				next, ok := table[segment].(map[string]any)
+				if !ok {
+					next = map[string]any{}
+					table[segment] = next
+				}
+				table = next
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("toml: line %d: expected \"key = value\", got %q", n+1, line)
+		}
+		table[strings.TrimSpace(key)] = parseTOMLScalar(strings.TrimSpace(value))
+	}
+
+	return root, nil
+}
+
+func parseTOMLScalar(s string) any {
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		inner := strings.TrimSpace(s[1 : len(s)-1])
+		if inner == "" {
+			return []any{}
+		}
+		parts := strings.Split(inner, ",")
+		items := make([]any, len(parts))
+		for i, p := range parts {
+			items[i] = parseTOMLScalar(strings.TrimSpace(p))
+		}
+		return items
+	}
+
+	// Literal strings ('...'): no escape processing, unlike basic "..."
+	// strings.
+	if len(s) >= 2 && strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") {
+		return s[1 : len(s)-1]
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch loads the config once, sends it, then re-loads and sends again
+// whenever any of the loader's files change on disk. It watches each
+// file's containing directory rather than the file itself, so the
+// write-temp-then-rename pattern most editors and config-management tools
+// use is caught even though the rename replaces the watched inode. A
+// failed reload is treated as transient: it's skipped (left for the next
+// change event to retry) rather than closing the channel, so a momentary
+// bad write doesn't kill the watch. The channel is closed when ctx is
+// done, the watcher can't be started, or the very first Load fails.
+func (l *Loader[T]) Watch(ctx context.Context) <-chan *T {
+	out := make(chan *T)
+
+	go func() {
+		defer close(out)
+
+		value, err := l.Load(ctx)
+		if err != nil {
+			return
+		}
+
+		select {
+		case out <- value:
+		case <-ctx.Done():
+			return
+		}
+
+		if len(l.files) == 0 {
+			<-ctx.Done()
+			return
+		}
+
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return
+		}
+		defer watcher.Close()
+
+		watched := map[string]bool{}
+		for _, path := range l.files {
+			dir := filepath.Dir(path)
+			if watched[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				return
+			}
+			watched[dir] = true
+		}
+
+		names := map[string]bool{}
+		for _, path := range l.files {
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				abs = path
+			}
+			names[abs] = true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				abs, err := filepath.Abs(event.Name)
+				if err != nil {
+					abs = event.Name
+				}
+				if !names[abs] {
+					continue
+				}
+				// Write covers in-place saves; Create covers the rename
+				// that lands a new inode on the watched path (the common
+				// write-temp-then-rename pattern).
+				if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+					continue
+				}
+
+				value, err := l.Load(ctx)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- value:
+				case <-ctx.Done():
+					return
+				}
+
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML parses the subset of YAML this package's config files actually
+// use: nested mappings at any consistent indentation width, scalar values
+// (strings, ints, floats, bools), inline flow lists ("[a, b, c]"), and
+// block-style lists ("key:" followed by indented "- item" lines). It does
+// not attempt to support anchors, multi-document streams, or flow
+// mappings.
+func parseYAML(data []byte) (map[string]any, error) {
+	lines := splitNonEmptyLines(string(data))
+	root := map[string]any{}
+	_, err := parseYAMLBlock(lines, 0, 0, root)
+	return root, err
+}
+
+// parseYAMLBlock consumes lines starting at index start that belong to the
+// mapping at indent, writing parsed keys into into. It returns the index
+// of the first line not consumed.
+func parseYAMLBlock(lines []yamlLine, start, indent int, into map[string]any) (int, error) {
+	i := start
+	for i < len(lines) {
+		line := lines[i]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return i, fmt.Errorf("unexpected indentation at %q", line.text)
+		}
+
+		key, rest, ok := strings.Cut(line.text, ":")
+		if !ok {
+			return i, fmt.Errorf("expected \"key: value\" at %q", line.text)
+		}
+		key = strings.TrimSpace(key)
+		rest = strings.TrimSpace(rest)
+
+		if rest != "" {
+			into[key] = parseYAMLScalar(rest)
+			i++
+			continue
+		}
+
+		// Empty value: either a block list or a nested mapping, both
+		// indented more than the current key. The child indentation isn't
+		// assumed to be indent+2 — it's whatever the next line actually
+		// uses, so 2-space and 4-space (or any other consistent) styles
+		// both work.
+		if i+1 >= len(lines) || lines[i+1].indent <= indent {
+			return i, fmt.Errorf("key %q has no value or nested block", key)
+		}
+		childIndent := lines[i+1].indent
+
+		if strings.HasPrefix(lines[i+1].text, "-") {
+			items, next := parseYAMLList(lines, i+1, childIndent)
+			into[key] = items
+			i = next
+			continue
+		}
+
+		nested := map[string]any{}
+		next, err := parseYAMLBlock(lines, i+1, childIndent, nested)
+		if err != nil {
+			return i, err
+		}
+		into[key] = nested
+		i = next
+	}
+	return i, nil
+}
+
+// parseYAMLList consumes consecutive "- item" lines at indent, returning
+// the parsed items and the index of the first line not consumed.
+func parseYAMLList(lines []yamlLine, start, indent int) ([]any, int) {
+	var items []any
+	i := start
+	for i < len(lines) {
+		line := lines[i]
+		if line.indent != indent || !strings.HasPrefix(line.text, "-") {
+			break
+		}
+		item := strings.TrimSpace(strings.TrimPrefix(line.text, "-"))
+		items = append(items, parseYAMLScalar(item))
+		i++
+	}
+	return items, i
+}
+
+func parseYAMLScalar(s string) any {
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		inner := strings.TrimSpace(s[1 : len(s)-1])
+		if inner == "" {
+			return []any{}
+		}
+		parts := strings.Split(inner, ",")
+		items := make([]any, len(parts))
+		for i, p := range parts {
+			items[i] = parseYAMLScalar(strings.TrimSpace(p))
+		}
+		return items
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	if trimmed := strings.Trim(s, "'"); trimmed != s {
+		return trimmed
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+type yamlLine struct {
+	indent int
+	text   string
+}
+
+func splitNonEmptyLines(data string) []yamlLine {
+	var lines []yamlLine
+	for _, raw := range strings.Split(data, "\n") {
+		trimmed := strings.TrimRight(raw, " \r\t")
+		content := strings.TrimLeft(trimmed, " ")
+		if content == "" || strings.HasPrefix(content, "#") {
+			continue
+		}
+		lines = append(lines, yamlLine{indent: len(trimmed) - len(content), text: content})
+	}
+	return lines
+}
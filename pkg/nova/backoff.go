@@ -0,0 +1,18 @@
+package nova
+
+import (
+	"math/rand"
+	"time"
+)
+
+// backoff computes an exponential-with-jitter delay for the given retry
+// attempt (0-indexed), capped at max. It's used by WatchState to ride out
+// transient HTTP errors without hammering the API.
+func backoff(attempt int, base, max time.Duration) time.Duration {
+	d := base << attempt
+	if d <= 0 || d > max {
+		d = max
+	}
+	// Full jitter: a random duration in [0, d).
+	return time.Duration(rand.Int63n(int64(d)))
+}
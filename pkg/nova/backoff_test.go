@@ -0,0 +1,40 @@
+package nova
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffCapsAtMax(t *testing.T) {
+	const (
+		base = 250 * time.Millisecond
+		max  = 10 * time.Second
+	)
+
+	// Attempt large enough that base<<attempt overflows time.Duration
+	// (and, below the overflow point, comfortably exceeds max) — both
+	// cases must be capped at max rather than producing a negative or
+	// oversized jitter bound.
+	for _, attempt := range []int{10, 62, 63, 100} {
+		for i := 0; i < 20; i++ {
+			d := backoff(attempt, base, max)
+			if d < 0 || d >= max {
+				t.Fatalf("backoff(%d, %s, %s) = %s, want in [0, %s)", attempt, base, max, d, max)
+			}
+		}
+	}
+}
+
+func TestBackoffWithinBaseForFirstAttempt(t *testing.T) {
+	const (
+		base = 250 * time.Millisecond
+		max  = 10 * time.Second
+	)
+
+	for i := 0; i < 20; i++ {
+		d := backoff(0, base, max)
+		if d < 0 || d >= base {
+			t.Fatalf("backoff(0, %s, %s) = %s, want in [0, %s)", base, max, d, base)
+		}
+	}
+}
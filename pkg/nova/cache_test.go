@@ -0,0 +1,35 @@
+package nova
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCacheGetSet(t *testing.T) {
+	c := newTTLCache[string, int](time.Minute)
+
+	if _, ok := c.get("a"); ok {
+		t.Fatal("get on empty cache returned ok=true")
+	}
+
+	value := 42
+	c.set("a", &value)
+
+	got, ok := c.get("a")
+	if !ok || *got != value {
+		t.Fatalf("get(%q) = %v, %v, want %v, true", "a", got, ok, value)
+	}
+}
+
+func TestTTLCacheExpiry(t *testing.T) {
+	c := newTTLCache[string, int](time.Millisecond)
+
+	value := 1
+	c.set("a", &value)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.get("a"); ok {
+		t.Fatal("get after ttl elapsed returned ok=true, want expired")
+	}
+}
@@ -0,0 +1,41 @@
+package nova
+
+import (
+	"context"
+	"fmt"
+)
+
+// Cell is a Nova cell: the top-level grouping of controllers.
+type Cell struct {
+	client *Client
+	name   string
+}
+
+// Name returns the cell's name.
+func (c *Cell) Name() string { return c.name }
+
+// Controllers lists the controllers registered in this cell.
+func (c *Cell) Controllers(ctx context.Context) ([]*Controller, error) {
+	c.client.metrics.requests.Add(1)
+	resp, err := c.client.api.ListControllersWithResponse(ctx, c.name)
+	if err != nil {
+		c.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: list controllers for cell %q: %w", c.name, err)
+	}
+	if resp.JSON200 == nil {
+		c.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: list controllers for cell %q: unexpected status %d", c.name, resp.StatusCode())
+	}
+
+	controllers := make([]*Controller, 0, len(*resp.JSON200))
+	for _, name := range *resp.JSON200 {
+		controllers = append(controllers, &Controller{client: c.client, cell: c.name, name: name})
+	}
+	return controllers, nil
+}
+
+// Controller returns a handle to a specific controller without listing all
+// of them, for callers that already know the controller name.
+func (c *Cell) Controller(name string) *Controller {
+	return &Controller{client: c.client, cell: c.name, name: name}
+}
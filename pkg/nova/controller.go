@@ -0,0 +1,63 @@
+package nova
+
+import (
+	"context"
+	"fmt"
+
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+// Controller is a single robot controller within a Cell.
+type Controller struct {
+	client *Client
+	cell   string
+	name   string
+}
+
+// Name returns the controller's name.
+func (c *Controller) Name() string { return c.name }
+
+// description fetches the controller description, serving it from the
+// client's TTL cache when possible so repeated dashboard-style scans don't
+// hammer the API.
+func (c *Controller) description(ctx context.Context) (*v2.ControllerDescription, error) {
+	key := c.cell + "/" + c.name
+	if desc, ok := c.client.descCache.get(key); ok {
+		return desc, nil
+	}
+
+	c.client.metrics.requests.Add(1)
+	resp, err := c.client.api.GetControllerDescriptionWithResponse(ctx, c.cell, c.name)
+	if err != nil {
+		c.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: get controller description for %s/%s: %w", c.cell, c.name, err)
+	}
+	if resp.JSON200 == nil {
+		c.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: get controller description for %s/%s: unexpected status %d", c.cell, c.name, resp.StatusCode())
+	}
+
+	c.client.descCache.set(key, resp.JSON200)
+	return resp.JSON200, nil
+}
+
+// MotionGroups lists the motion groups currently connected to this
+// controller.
+func (c *Controller) MotionGroups(ctx context.Context) ([]*MotionGroup, error) {
+	desc, err := c.description(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*MotionGroup, 0, len(desc.ConnectedMotionGroups))
+	for _, mg := range desc.ConnectedMotionGroups {
+		groups = append(groups, &MotionGroup{client: c.client, cell: c.cell, controller: c.name, name: mg})
+	}
+	return groups, nil
+}
+
+// MotionGroup returns a handle to a specific motion group without listing
+// all of them, for callers that already know its name.
+func (c *Controller) MotionGroup(name string) *MotionGroup {
+	return &MotionGroup{client: c.client, cell: c.cell, controller: c.name, name: name}
+}
@@ -0,0 +1,56 @@
+package nova
+
+import (
+	"expvar"
+	"strconv"
+)
+
+// Metrics holds the operational counters and gauges a Client updates as it
+// talks to the Nova API. Values are backed by expvar.Int/expvar.Map so they
+// can be published under expvar.Publish for scraping (e.g. via a
+// Prometheus expvar exporter) without this package depending on a specific
+// metrics backend.
+type Metrics struct {
+	requests *expvar.Int
+	errors   *expvar.Int
+
+	// lastPositions maps "cell/controller/motionGroup" to the most recently
+	// observed joint positions, as a comma-separated string (see
+	// formatPositions), for gauge-style inspection.
+	lastPositions *expvar.Map
+}
+
+// NewMetrics allocates a standalone Metrics instance. It is not published
+// under expvar by default; call Publish to expose it under a name.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests:      new(expvar.Int),
+		errors:        new(expvar.Int),
+		lastPositions: new(expvar.Map).Init(),
+	}
+}
+
+// Publish registers the metrics under expvar using the given prefix, e.g.
+// "nova_requests_total", "nova_errors_total", "nova_last_positions".
+func (m *Metrics) Publish(prefix string) {
+	expvar.Publish(prefix+"_requests_total", m.requests)
+	expvar.Publish(prefix+"_errors_total", m.errors)
+	expvar.Publish(prefix+"_last_positions", m.lastPositions)
+}
+
+// Requests returns the total number of API calls made so far.
+func (m *Metrics) Requests() int64 { return m.requests.Value() }
+
+// Errors returns the total number of failed API calls so far.
+func (m *Metrics) Errors() int64 { return m.errors.Value() }
+
+func (m *Metrics) recordPositions(key, value string) {
+	m.lastPositions.Set(key, asExpvarString(value))
+}
+
+// asExpvarString adapts a plain string to the expvar.Var interface (whose
+// String method must return a JSON-encoded value) so it can be stored in
+// an expvar.Map.
+type asExpvarString string
+
+func (s asExpvarString) String() string { return strconv.Quote(string(s)) }
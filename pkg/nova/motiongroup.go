@@ -0,0 +1,129 @@
+package nova
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+// MotionGroup is a single motion group (robot arm) attached to a Controller.
+type MotionGroup struct {
+	client     *Client
+	cell       string
+	controller string
+	name       string
+}
+
+// Name returns the motion group's name.
+func (mg *MotionGroup) Name() string { return mg.name }
+
+// State fetches the motion group's current state.
+func (mg *MotionGroup) State(ctx context.Context) (*v2.MotionGroupStateResponse, error) {
+	mg.client.metrics.requests.Add(1)
+	resp, err := mg.client.api.GetMotionGroupStateWithResponse(ctx, mg.cell, mg.controller, mg.name)
+	if err != nil {
+		mg.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: get motion group state for %s/%s/%s: %w", mg.cell, mg.controller, mg.name, err)
+	}
+	if resp.JSON200 == nil {
+		mg.client.metrics.errors.Add(1)
+		return nil, fmt.Errorf("nova: get motion group state for %s/%s/%s: unexpected status %d", mg.cell, mg.controller, mg.name, resp.StatusCode())
+	}
+	return resp.JSON200, nil
+}
+
+// StateEvent is a single observation delivered by WatchState.
+type StateEvent struct {
+	State *v2.MotionGroupStateResponse
+}
+
+// WatchState polls the motion group's state at the client's configured
+// poll interval (see WithPollInterval), sending an event each time the
+// joint positions change. Unchanged polls are suppressed. All polling
+// errors are treated as transient: they're retried internally with
+// exponential backoff and jitter and are never surfaced on the channel.
+// A motion group that can never be reached (bad credentials, wrong name,
+// etc.) will therefore retry indefinitely rather than erroring out; callers
+// that need to notice that should apply their own deadline via ctx.
+//
+// The returned channel is closed when ctx is canceled.
+func (mg *MotionGroup) WatchState(ctx context.Context) <-chan StateEvent {
+	key := mg.cell + "/" + mg.controller + "/" + mg.name
+	return watchState(ctx, mg.State, mg.client.pollInterval, key, mg.client.metrics)
+}
+
+// watchState implements WatchState's polling loop over fetch rather than a
+// *MotionGroup directly, so the dedup/backoff/cancellation logic can be
+// tested without a live API client.
+func watchState(ctx context.Context, fetch func(context.Context) (*v2.MotionGroupStateResponse, error), pollInterval time.Duration, metricsKey string, metrics *Metrics) <-chan StateEvent {
+	events := make(chan StateEvent)
+
+	go func() {
+		defer close(events)
+
+		const (
+			backoffBase = 250 * time.Millisecond
+			backoffMax  = 10 * time.Second
+		)
+
+		var (
+			lastPositions string
+			haveLast      bool
+			failures      int
+		)
+
+		for {
+			state, err := fetch(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				failures++
+				select {
+				case <-time.After(backoff(failures-1, backoffBase, backoffMax)):
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			failures = 0
+
+			positions := formatPositions(state)
+			if !haveLast || positions != lastPositions {
+				haveLast = true
+				lastPositions = positions
+				metrics.recordPositions(metricsKey, positions)
+
+				select {
+				case events <- StateEvent{State: state}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(pollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// formatPositions renders a state's joint positions into a comparable,
+// loggable string for change detection and metrics.
+func formatPositions(state *v2.MotionGroupStateResponse) string {
+	if state == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(state.Positions))
+	for _, p := range state.Positions {
+		parts = append(parts, fmt.Sprintf("%v", p))
+	}
+	return strings.Join(parts, ",")
+}
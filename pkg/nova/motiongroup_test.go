@@ -0,0 +1,107 @@
+package nova
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+// fetchSeq returns a fetch func that yields states (or errors) from seq in
+// order, then repeats the last entry forever.
+func fetchSeq(seq []fetchResult) func(context.Context) (*v2.MotionGroupStateResponse, error) {
+	var (
+		mu sync.Mutex
+		i  int
+	)
+	return func(context.Context) (*v2.MotionGroupStateResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		r := seq[i]
+		if i < len(seq)-1 {
+			i++
+		}
+		return r.state, r.err
+	}
+}
+
+type fetchResult struct {
+	state *v2.MotionGroupStateResponse
+	err   error
+}
+
+func TestWatchStateSuppressesUnchangedPolls(t *testing.T) {
+	fetch := fetchSeq([]fetchResult{
+		{state: &v2.MotionGroupStateResponse{Positions: []float64{1, 2, 3}}},
+		{state: &v2.MotionGroupStateResponse{Positions: []float64{1, 2, 3}}},
+		{state: &v2.MotionGroupStateResponse{Positions: []float64{4, 5, 6}}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := watchState(ctx, fetch, time.Millisecond, "test", NewMetrics())
+
+	first := <-events
+	if got := first.State.Positions[0]; got != 1 {
+		t.Fatalf("first event positions[0] = %v, want 1", got)
+	}
+
+	second := <-events
+	if got := second.State.Positions[0]; got != 4 {
+		t.Fatalf("second event positions[0] = %v, want 4 (unchanged poll should have been suppressed)", got)
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Fatal("events channel still open after ctx cancel")
+	}
+}
+
+func TestWatchStateClosesChannelOnCancel(t *testing.T) {
+	fetch := fetchSeq([]fetchResult{
+		{state: &v2.MotionGroupStateResponse{Positions: []float64{1}}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := watchState(ctx, fetch, time.Hour, "test", NewMetrics())
+
+	<-events // initial state, then the loop blocks on the (1h) poll interval
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("received unexpected event after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("events channel did not close within 1s of ctx cancel")
+	}
+}
+
+func TestWatchStateRetriesOnError(t *testing.T) {
+	wantErr := errors.New("transient")
+	fetch := fetchSeq([]fetchResult{
+		{err: wantErr},
+		{err: wantErr},
+		{state: &v2.MotionGroupStateResponse{Positions: []float64{9}}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := watchState(ctx, fetch, time.Millisecond, "test", NewMetrics())
+
+	select {
+	case ev := <-events:
+		if got := ev.State.Positions[0]; got != 9 {
+			t.Fatalf("event positions[0] = %v, want 9", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watchState did not retry past errors within 2s")
+	}
+}
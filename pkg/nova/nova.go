@@ -0,0 +1,76 @@
+// Package nova provides a typed, stateful client for the Wandelbots Nova
+// cell/controller/motion-group hierarchy, built on top of the generated
+// nova-api-client-go client. It adds polling-based state streaming, a TTL
+// cache for controller descriptions, and basic operational metrics so
+// dashboard-style callers don't need to reimplement that plumbing against
+// the raw API client.
+package nova
+
+import (
+	"time"
+
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+const (
+	// DefaultPollInterval is used by WatchState when no interval is configured.
+	DefaultPollInterval = 500 * time.Millisecond
+	// DefaultDescriptionTTL is how long a controller description is cached
+	// before MotionGroups re-fetches it.
+	DefaultDescriptionTTL = 30 * time.Second
+)
+
+// Client wraps a generated nova-api-client and holds the shared state
+// (caches, metrics, polling configuration) used by Cell/Controller/MotionGroup.
+type Client struct {
+	api *v2.ClientWithResponses
+
+	pollInterval   time.Duration
+	descriptionTTL time.Duration
+
+	descCache *ttlCache[string, v2.ControllerDescription]
+	metrics   *Metrics
+}
+
+// Option configures a Client returned by NewClient.
+type Option func(*Client)
+
+// WithPollInterval overrides the interval WatchState uses when polling for
+// new state.
+func WithPollInterval(d time.Duration) Option {
+	return func(c *Client) { c.pollInterval = d }
+}
+
+// WithDescriptionTTL overrides how long controller descriptions are cached.
+func WithDescriptionTTL(d time.Duration) Option {
+	return func(c *Client) { c.descriptionTTL = d }
+}
+
+// WithMetrics installs a Metrics instance, e.g. one published via expvar.
+// If omitted, a Client allocates its own unpublished Metrics.
+func WithMetrics(m *Metrics) Option {
+	return func(c *Client) { c.metrics = m }
+}
+
+// NewClient builds a Client around an existing generated API client. Pass
+// ClientOptions (such as those from nova_ext/auth) when constructing api.
+func NewClient(api *v2.ClientWithResponses, opts ...Option) *Client {
+	c := &Client{
+		api:            api,
+		pollInterval:   DefaultPollInterval,
+		descriptionTTL: DefaultDescriptionTTL,
+		metrics:        NewMetrics(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.descCache = newTTLCache[string, v2.ControllerDescription](c.descriptionTTL)
+	return c
+}
+
+// Cell returns a handle to the cell identified by name. Cells are not
+// discovered via the API; callers are expected to know the cell name they
+// are targeting (it's part of the deployment, not a runtime concept).
+func (c *Client) Cell(name string) *Cell {
+	return &Cell{client: c, name: name}
+}
@@ -0,0 +1,32 @@
+// Package auth provides authentication for the Nova API client beyond a
+// static bearer token: OAuth2 client-credentials with automatic refresh,
+// and OIDC discovery to auto-populate the relevant endpoints.
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// AuthProvider produces bearer tokens for outgoing Nova API requests. Token
+// returns the current token along with its expiry; implementations that
+// never expire (e.g. StaticToken) return a zero time.Time.
+type AuthProvider interface {
+	Token(ctx context.Context) (string, time.Time, error)
+}
+
+// StaticToken is an AuthProvider backed by a single, unchanging bearer
+// token, matching the engine's previous withAuthToken behavior.
+type StaticToken struct {
+	token string
+}
+
+// NewStaticToken returns an AuthProvider that always returns token.
+func NewStaticToken(token string) *StaticToken {
+	return &StaticToken{token: token}
+}
+
+// Token implements AuthProvider.
+func (s *StaticToken) Token(ctx context.Context) (string, time.Time, error) {
+	return s.token, time.Time{}, nil
+}
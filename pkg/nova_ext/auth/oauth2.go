@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// refreshWindow is how far ahead of expiry a cached token is considered
+// stale and eligible for a background refresh.
+const refreshWindow = 30 * time.Second
+
+// ClientCredentials is an AuthProvider implementing the OAuth2
+// client-credentials grant against a configurable token URL, with
+// automatic refresh before the cached token expires.
+type ClientCredentials struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	cachedToken string
+	expiresAt   time.Time
+	refreshErr  error
+	// inflight is non-nil while a refresh is running and is closed when it
+	// completes, so a caller that needs to block (no cached token yet) can
+	// wait on an already-running refresh instead of starting another one.
+	inflight chan struct{}
+}
+
+// ClientCredentialsOption configures a ClientCredentials provider.
+type ClientCredentialsOption func(*ClientCredentials)
+
+// WithScopes sets the OAuth2 scopes requested on each token fetch.
+func WithScopes(scopes ...string) ClientCredentialsOption {
+	return func(c *ClientCredentials) { c.scopes = scopes }
+}
+
+// WithHTTPClient overrides the http.Client used to reach the token
+// endpoint. Defaults to http.DefaultClient.
+func WithHTTPClient(hc *http.Client) ClientCredentialsOption {
+	return func(c *ClientCredentials) { c.httpClient = hc }
+}
+
+// NewClientCredentials builds a ClientCredentials provider for the given
+// token URL and client credentials.
+func NewClientCredentials(tokenURL, clientID, clientSecret string, opts ...ClientCredentialsOption) *ClientCredentials {
+	c := &ClientCredentials{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		httpClient:   http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Token implements AuthProvider. It serves the cached token when it has
+// more than refreshWindow left, refreshes synchronously when no token is
+// cached yet or the cached one has expired, and otherwise kicks off a
+// background refresh while returning
+// the still-valid cached token. At most one refresh runs at a time: a
+// caller that needs to block while another goroutine is already
+// refreshing waits on that refresh instead of starting a redundant one.
+func (c *ClientCredentials) Token(ctx context.Context) (string, time.Time, error) {
+	c.mu.Lock()
+	token, expiresAt := c.cachedToken, c.expiresAt
+	needsRefresh := token == "" || time.Until(expiresAt) < refreshWindow
+	if !needsRefresh {
+		c.mu.Unlock()
+		return token, expiresAt, nil
+	}
+
+	shouldBlock := token == "" || !time.Now().Before(expiresAt)
+	inflight := c.inflight
+	if inflight != nil {
+		c.mu.Unlock()
+		if !shouldBlock {
+			return token, expiresAt, nil
+		}
+		return c.awaitRefresh(inflight)
+	}
+
+	inflight = make(chan struct{})
+	c.inflight = inflight
+	c.mu.Unlock()
+
+	if shouldBlock {
+		return c.refresh(ctx, inflight)
+	}
+
+	go func() {
+		// Use a background context: the caller's ctx may be canceled
+		// before this refresh completes, but the refreshed token is
+		// still useful for subsequent calls.
+		_, _, _ = c.refresh(context.Background(), inflight)
+	}()
+	return token, expiresAt, nil
+}
+
+// awaitRefresh blocks until the in-flight refresh signaled by inflight
+// completes, then returns its result.
+func (c *ClientCredentials) awaitRefresh(inflight chan struct{}) (string, time.Time, error) {
+	<-inflight
+
+	c.mu.Lock()
+	token, expiresAt, err := c.cachedToken, c.expiresAt, c.refreshErr
+	c.mu.Unlock()
+
+	if token == "" && err == nil {
+		err = fmt.Errorf("auth: token refresh did not produce a token")
+	}
+	return token, expiresAt, err
+}
+
+// refresh performs the token fetch and, regardless of outcome, clears
+// c.inflight and closes it so any waiters proceed.
+func (c *ClientCredentials) refresh(ctx context.Context, inflight chan struct{}) (string, time.Time, error) {
+	token, expiresAt, err := c.doRefresh(ctx)
+
+	c.mu.Lock()
+	c.refreshErr = err
+	c.inflight = nil
+	c.mu.Unlock()
+	close(inflight)
+
+	return token, expiresAt, err
+}
+
+// doRefresh performs the actual OAuth2 client-credentials token request.
+func (c *ClientCredentials) doRefresh(ctx context.Context) (string, time.Time, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+	}
+	if len(c.scopes) > 0 {
+		form.Set("scope", strings.Join(c.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("auth: build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("auth: fetch token from %s: %w", c.tokenURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("auth: read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("auth: token endpoint %s returned %d: %s", c.tokenURL, resp.StatusCode, body)
+	}
+
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", time.Time{}, fmt.Errorf("auth: decode token response: %w", err)
+	}
+	if payload.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("auth: token endpoint %s returned no access_token", c.tokenURL)
+	}
+
+	expiresAt := time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+
+	c.mu.Lock()
+	c.cachedToken = payload.AccessToken
+	c.expiresAt = expiresAt
+	c.mu.Unlock()
+
+	return payload.AccessToken, expiresAt, nil
+}
+
+// invalidate drops the cached token, forcing the next Token call to block
+// on a synchronous refresh. Used by Transport when it sees a 401.
+func (c *ClientCredentials) invalidate() {
+	c.mu.Lock()
+	c.cachedToken = ""
+	c.expiresAt = time.Time{}
+	c.refreshErr = nil
+	c.mu.Unlock()
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+// invalidator is implemented by providers that can be told their current
+// token was rejected, so the next Token call forces a fresh fetch instead
+// of returning a still-cached (but server-rejected) value.
+type invalidator interface {
+	invalidate()
+}
+
+// transport is an http.RoundTripper that attaches a bearer token from an
+// AuthProvider to every request. If the server responds 401 and the
+// provider implements invalidator, it invalidates the provider's cache and
+// retries once; otherwise the 401 response is returned as is.
+type transport struct {
+	base     http.RoundTripper
+	provider AuthProvider
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.doRequest(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if inv, ok := t.provider.(invalidator); ok {
+		inv.invalidate()
+	} else {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	return t.doRequest(req)
+}
+
+func (t *transport) doRequest(req *http.Request) (*http.Response, error) {
+	token, _, err := t.provider.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("auth: get token: %w", err)
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(clone)
+}
+
+// ClientOption adapts an AuthProvider into a v2.ClientOption: it installs
+// an HTTP transport that attaches the provider's token to every request
+// and, for providers that can invalidate their cached token (such as
+// ClientCredentials), transparently re-authenticates on a 401 response.
+func ClientOption(provider AuthProvider) v2.ClientOption {
+	return v2.WithHTTPClient(&http.Client{
+		Transport: &transport{base: http.DefaultTransport, provider: provider},
+	})
+}
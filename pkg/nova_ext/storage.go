@@ -4,45 +4,41 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 
+	"github.com/ardo314/engine/pkg/nova"
+	"github.com/ardo314/engine/pkg/nova_ext/auth"
 	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
 )
 
+// printMotionGroupPositions prints the current joint positions of every
+// motion group connected to controller, using the nova package rather than
+// talking to the generated API client directly.
 func printMotionGroupPositions(client *v2.ClientWithResponses, cell, controller string) {
-	resp, err := client.GetControllerDescriptionWithResponse(context.TODO(), cell, controller)
+	ctrl := nova.NewClient(client).Cell(cell).Controller(controller)
+
+	groups, err := ctrl.MotionGroups(context.TODO())
 	if err != nil {
 		log.Printf("Failed to get robot controller %s: %v", controller, err)
 		return
 	}
 
-	if resp.JSON200 == nil {
-		log.Printf("No data found for robot controller %s", controller)
-		return
-	}
-
-	motionGroups := resp.JSON200.ConnectedMotionGroups
-	for _, mg := range motionGroups {
-		printMotionGroupPosition(client, cell, controller, mg)
+	for _, mg := range groups {
+		printMotionGroupPosition(mg)
 	}
 }
 
-func printMotionGroupPosition(client *v2.ClientWithResponses, cell, controller, motionGroup string) {
-	resp, err := client.GetMotionGroupStateWithResponse(context.TODO(), cell, controller, motionGroup)
+func printMotionGroupPosition(mg *nova.MotionGroup) {
+	state, err := mg.State(context.TODO())
 	if err != nil {
-		log.Printf("Failed to get motion group %s state: %v", motionGroup, err)
-		return
-	}
-	if resp.JSON200 == nil {
-		log.Printf("No data found for motion group %s on controller %s", motionGroup, controller)
+		log.Printf("Failed to get motion group %s state: %v", mg.Name(), err)
 		return
 	}
-	fmt.Println("controller:", controller, "motionGroup:", motionGroup, "joint positions:", resp.JSON200.Positions)
+	fmt.Println("motionGroup:", mg.Name(), "joint positions:", state.Positions)
 }
 
+// withAuthToken authenticates the client with a static bearer token. For
+// OAuth2/OIDC, build an auth.AuthProvider (e.g. auth.NewClientCredentials
+// or auth.NewOIDC) and pass auth.ClientOption(provider) instead.
 func withAuthToken(token string) v2.ClientOption {
-	return v2.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", "Bearer "+token)
-		return nil
-	})
+	return auth.ClientOption(auth.NewStaticToken(token))
 }
@@ -0,0 +1,114 @@
+// Package nova registers a robot.Backend driver ("nova") backed by a real
+// Wandelbots Nova deployment, adapting pkg/nova's Cell/Controller/
+// MotionGroup hierarchy onto the vendor-neutral robot interfaces.
+package nova
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ardo314/engine/pkg/nova"
+	"github.com/ardo314/engine/pkg/nova_ext/auth"
+	"github.com/ardo314/engine/pkg/robot"
+	v2 "github.com/wandelbotsgmbh/nova-api-client-go/v25/pkg/nova/v2"
+)
+
+func init() {
+	robot.Register("nova", newBackend)
+}
+
+// newBackend builds a Nova-backed robot.Backend. cfg recognizes:
+//   - "base_url" (required): the Nova API base URL
+//   - "cell" (required): the cell name to operate against
+//   - "token" (optional): a static bearer token; use auth.ClientOption
+//     directly and wrap the result if OAuth2/OIDC auth is needed instead
+func newBackend(cfg robot.Config) (robot.Backend, error) {
+	baseURL, _ := cfg["base_url"].(string)
+	if baseURL == "" {
+		return nil, fmt.Errorf("robot/nova: config requires a non-empty \"base_url\"")
+	}
+	cellName, _ := cfg["cell"].(string)
+	if cellName == "" {
+		return nil, fmt.Errorf("robot/nova: config requires a non-empty \"cell\"")
+	}
+
+	var opts []v2.ClientOption
+	if token, _ := cfg["token"].(string); token != "" {
+		opts = append(opts, auth.ClientOption(auth.NewStaticToken(token)))
+	}
+
+	api, err := v2.NewClientWithResponses(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("robot/nova: build api client: %w", err)
+	}
+
+	return &backend{cell: nova.NewClient(api).Cell(cellName)}, nil
+}
+
+type backend struct {
+	cell *nova.Cell
+}
+
+func (b *backend) Controllers(ctx context.Context) ([]robot.Controller, error) {
+	ctrls, err := b.cell.Controllers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]robot.Controller, len(ctrls))
+	for i, c := range ctrls {
+		out[i] = &controller{c}
+	}
+	return out, nil
+}
+
+func (b *backend) Controller(name string) robot.Controller {
+	return &controller{b.cell.Controller(name)}
+}
+
+type controller struct {
+	c *nova.Controller
+}
+
+func (c *controller) Name() string { return c.c.Name() }
+
+func (c *controller) MotionGroups(ctx context.Context) ([]robot.MotionGroup, error) {
+	groups, err := c.c.MotionGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]robot.MotionGroup, len(groups))
+	for i, g := range groups {
+		out[i] = &motionGroup{g}
+	}
+	return out, nil
+}
+
+type motionGroup struct {
+	mg *nova.MotionGroup
+}
+
+func (m *motionGroup) Name() string { return m.mg.Name() }
+
+func (m *motionGroup) State(ctx context.Context) (robot.JointState, error) {
+	state, err := m.mg.State(ctx)
+	if err != nil {
+		return robot.JointState{}, err
+	}
+	return robot.JointState{Positions: state.Positions}, nil
+}
+
+// TCPPose, MoveLinear, and MoveJoint have no equivalent in pkg/nova yet
+// (it only exposes state reads), so this driver reports them as
+// unsupported rather than guessing at an API shape.
+
+func (m *motionGroup) TCPPose(ctx context.Context) (robot.TCPPose, error) {
+	return robot.TCPPose{}, robot.ErrUnsupported
+}
+
+func (m *motionGroup) MoveLinear(ctx context.Context, target robot.TCPPose) error {
+	return robot.ErrUnsupported
+}
+
+func (m *motionGroup) MoveJoint(ctx context.Context, target robot.JointState) error {
+	return robot.ErrUnsupported
+}
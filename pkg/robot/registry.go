@@ -0,0 +1,44 @@
+package robot
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Factory builds a Backend from driver-specific Config. Drivers register
+// one via Register, typically from an init function in the driver
+// package (see robot/nova and robot/sim).
+type Factory func(Config) (Backend, error)
+
+var (
+	mu       sync.RWMutex
+	backends = map[string]Factory{}
+)
+
+// Register makes a driver available under name. It panics if name is
+// already registered, mirroring database/sql's driver registry, since a
+// double registration is always a programming error (e.g. the driver
+// package imported twice under different names).
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := backends[name]; exists {
+		panic(fmt.Sprintf("robot: Register called twice for driver %q", name))
+	}
+	backends[name] = factory
+}
+
+// New builds a Backend using the driver registered under name. Drivers
+// register themselves via blank import, e.g.
+// `import _ "github.com/ardo314/engine/pkg/robot/nova"`.
+func New(name string, cfg Config) (Backend, error) {
+	mu.RLock()
+	factory, ok := backends[name]
+	mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("robot: unknown driver %q (forgotten blank import?)", name)
+	}
+	return factory(cfg)
+}
@@ -0,0 +1,57 @@
+// Package robot defines a vendor-neutral abstraction over robot-control
+// backends (Nova, a simulator, or future drivers) plus a registry drivers
+// plug themselves into, so the rest of the engine depends only on
+// robot.Backend and never on a specific vendor's API client.
+package robot
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrUnsupported is returned by a driver method for an operation its
+// backend does not (yet) implement, so callers can distinguish "not
+// supported by this backend" from a transport or request error.
+var ErrUnsupported = errors.New("robot: operation not supported by this backend")
+
+// JointState is a snapshot of a motion group's joint positions.
+type JointState struct {
+	Positions []float64
+	Timestamp time.Time
+}
+
+// TCPPose is a tool-center-point pose: a 3D position plus orientation
+// expressed as Euler angles, matching the convention used across the
+// engine's pose handling.
+type TCPPose struct {
+	X, Y, Z    float64
+	RX, RY, RZ float64
+}
+
+// MotionGroup is a single controllable robot arm.
+type MotionGroup interface {
+	Name() string
+	State(ctx context.Context) (JointState, error)
+	TCPPose(ctx context.Context) (TCPPose, error)
+	MoveLinear(ctx context.Context, target TCPPose) error
+	MoveJoint(ctx context.Context, target JointState) error
+}
+
+// Controller groups the motion groups attached to a single robot
+// controller.
+type Controller interface {
+	Name() string
+	MotionGroups(ctx context.Context) ([]MotionGroup, error)
+}
+
+// Backend is the entry point a driver implements: it lists the controllers
+// available from whatever cell/deployment/simulation it represents.
+type Backend interface {
+	Controllers(ctx context.Context) ([]Controller, error)
+	Controller(name string) Controller
+}
+
+// Config is the untyped configuration handed to a driver's factory
+// function; each driver documents the keys it reads.
+type Config map[string]any
@@ -0,0 +1,112 @@
+// Package sim registers an in-process robot.Backend driver ("sim") that
+// returns synthetic joint positions with no network calls, so code built
+// against robot.Backend can be exercised in tests without a live Nova
+// deployment.
+package sim
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ardo314/engine/pkg/robot"
+)
+
+func init() {
+	robot.Register("sim", newBackend)
+}
+
+// newBackend builds a fixed single-controller, single-motion-group
+// topology. cfg is unused; the simulator's shape is intentionally static.
+func newBackend(cfg robot.Config) (robot.Backend, error) {
+	return &backend{
+		controllers: map[string]*controller{
+			"sim-controller": {
+				name: "sim-controller",
+				motionGroups: map[string]*motionGroup{
+					"0": newMotionGroup("0", 6),
+				},
+			},
+		},
+	}, nil
+}
+
+type backend struct {
+	controllers map[string]*controller
+}
+
+func (b *backend) Controllers(ctx context.Context) ([]robot.Controller, error) {
+	out := make([]robot.Controller, 0, len(b.controllers))
+	for _, c := range b.controllers {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (b *backend) Controller(name string) robot.Controller {
+	if c, ok := b.controllers[name]; ok {
+		return c
+	}
+	return &controller{name: name}
+}
+
+type controller struct {
+	name         string
+	motionGroups map[string]*motionGroup
+}
+
+func (c *controller) Name() string { return c.name }
+
+func (c *controller) MotionGroups(ctx context.Context) ([]robot.MotionGroup, error) {
+	out := make([]robot.MotionGroup, 0, len(c.motionGroups))
+	for _, mg := range c.motionGroups {
+		out = append(out, mg)
+	}
+	return out, nil
+}
+
+// motionGroup holds its joint positions in memory and reports them back
+// as-is; MoveJoint writes directly into that state, and MoveLinear is
+// unsupported since the simulator has no inverse-kinematics model.
+type motionGroup struct {
+	name string
+
+	mu        sync.Mutex
+	positions []float64
+}
+
+func newMotionGroup(name string, joints int) *motionGroup {
+	return &motionGroup{name: name, positions: make([]float64, joints)}
+}
+
+func (m *motionGroup) Name() string { return m.name }
+
+func (m *motionGroup) State(ctx context.Context) (robot.JointState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return robot.JointState{
+		Positions: append([]float64(nil), m.positions...),
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (m *motionGroup) TCPPose(ctx context.Context) (robot.TCPPose, error) {
+	return robot.TCPPose{}, robot.ErrUnsupported
+}
+
+func (m *motionGroup) MoveJoint(ctx context.Context, target robot.JointState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(target.Positions) != len(m.positions) {
+		return fmt.Errorf("sim: motion group %s has %d joints, got %d positions", m.name, len(m.positions), len(target.Positions))
+	}
+	copy(m.positions, target.Positions)
+	return nil
+}
+
+func (m *motionGroup) MoveLinear(ctx context.Context, target robot.TCPPose) error {
+	return robot.ErrUnsupported
+}
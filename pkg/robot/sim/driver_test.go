@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ardo314/engine/pkg/robot"
+)
+
+func TestSimDriver(t *testing.T) {
+	ctx := context.Background()
+
+	backend, err := robot.New("sim", nil)
+	if err != nil {
+		t.Fatalf("robot.New(sim): %v", err)
+	}
+
+	controllers, err := backend.Controllers(ctx)
+	if err != nil {
+		t.Fatalf("Controllers: %v", err)
+	}
+	if len(controllers) != 1 || controllers[0].Name() != "sim-controller" {
+		t.Fatalf("Controllers = %v, want a single sim-controller", controllers)
+	}
+
+	ctrl := backend.Controller("sim-controller")
+	groups, err := ctrl.MotionGroups(ctx)
+	if err != nil {
+		t.Fatalf("MotionGroups: %v", err)
+	}
+	if len(groups) != 1 || groups[0].Name() != "0" {
+		t.Fatalf("MotionGroups = %v, want a single group named 0", groups)
+	}
+	mg := groups[0]
+
+	state, err := mg.State(ctx)
+	if err != nil {
+		t.Fatalf("State: %v", err)
+	}
+	if len(state.Positions) != 6 {
+		t.Fatalf("State.Positions has %d joints, want 6", len(state.Positions))
+	}
+
+	target := robot.JointState{Positions: []float64{1, 2, 3, 4, 5, 6}}
+	if err := mg.MoveJoint(ctx, target); err != nil {
+		t.Fatalf("MoveJoint: %v", err)
+	}
+	state, err = mg.State(ctx)
+	if err != nil {
+		t.Fatalf("State after MoveJoint: %v", err)
+	}
+	for i, p := range state.Positions {
+		if p != target.Positions[i] {
+			t.Errorf("State.Positions[%d] = %v, want %v", i, p, target.Positions[i])
+		}
+	}
+
+	if err := mg.MoveJoint(ctx, robot.JointState{Positions: []float64{1, 2, 3}}); err == nil {
+		t.Error("MoveJoint with mismatched joint count succeeded, want error")
+	}
+
+	if err := mg.MoveLinear(ctx, robot.TCPPose{}); !errors.Is(err, robot.ErrUnsupported) {
+		t.Errorf("MoveLinear = %v, want ErrUnsupported", err)
+	}
+	if _, err := mg.TCPPose(ctx); !errors.Is(err, robot.ErrUnsupported) {
+		t.Errorf("TCPPose = %v, want ErrUnsupported", err)
+	}
+
+	if ctrl := backend.Controller("unknown"); ctrl.Name() != "unknown" {
+		t.Errorf("Controller(unknown).Name() = %q, want %q", ctrl.Name(), "unknown")
+	}
+}
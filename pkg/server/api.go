@@ -0,0 +1,168 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// statePollInterval is how often handleStateStream re-reads state. The
+// robot.MotionGroup interface has no push-based watch, so streaming here
+// is a server-side poll loop, same as a dashboard client would otherwise
+// have to implement itself.
+const statePollInterval = 500 * time.Millisecond
+
+type nameResponse struct {
+	Name string `json:"name"`
+}
+
+type stateResponse struct {
+	Positions []float64 `json:"positions"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// handleLogin exchanges a valid bearer credential for the session cookie,
+// so a client that can't attach an Authorization header to every request
+// (in particular EventSource, used by the live state stream) can log in
+// once and rely on the cookie afterward.
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if !s.login.bearer.Authenticate(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	http.SetCookie(w, s.login.cookie.cookie())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleListCells(w http.ResponseWriter, r *http.Request) {
+	names := s.cellNames()
+	out := make([]nameResponse, len(names))
+	for i, name := range names {
+		out[i] = nameResponse{Name: name}
+	}
+	writeJSON(w, out)
+}
+
+func (s *Server) handleListControllers(w http.ResponseWriter, r *http.Request) {
+	backend, ok := s.cell(w, r)
+	if !ok {
+		return
+	}
+	controllers, err := backend.Controllers(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	names := make([]string, len(controllers))
+	for i, c := range controllers {
+		names[i] = c.Name()
+	}
+
+	if acceptsHTML(r) {
+		s.renderControllerList(w, r, names)
+		return
+	}
+	out := make([]nameResponse, len(names))
+	for i, name := range names {
+		out[i] = nameResponse{Name: name}
+	}
+	writeJSON(w, out)
+}
+
+func (s *Server) handleListMotionGroups(w http.ResponseWriter, r *http.Request) {
+	ctrl, ok := s.controller(w, r)
+	if !ok {
+		return
+	}
+	groups, err := ctrl.MotionGroups(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	names := make([]string, len(groups))
+	for i, mg := range groups {
+		names[i] = mg.Name()
+	}
+
+	if acceptsHTML(r) {
+		s.renderMotionGroupList(w, r, names)
+		return
+	}
+	out := make([]nameResponse, len(names))
+	for i, name := range names {
+		out[i] = nameResponse{Name: name}
+	}
+	writeJSON(w, out)
+}
+
+func (s *Server) handleState(w http.ResponseWriter, r *http.Request) {
+	mg, ok := s.motionGroup(w, r)
+	if !ok {
+		return
+	}
+	state, err := mg.State(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	writeJSON(w, stateResponse{Positions: state.Positions, Timestamp: state.Timestamp})
+}
+
+func (s *Server) handleStateStream(w http.ResponseWriter, r *http.Request) {
+	mg, ok := s.motionGroup(w, r)
+	if !ok {
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ctx := r.Context()
+	ticker := time.NewTicker(statePollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err := mg.State(ctx)
+		if err != nil {
+			fmtSSEEvent(w, "error", map[string]string{"error": err.Error()})
+		} else {
+			fmtSSEEvent(w, "state", stateResponse{Positions: state.Positions, Timestamp: state.Timestamp})
+		}
+		flusher.Flush()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fmtSSEEvent writes a single Server-Sent Event with a JSON-encoded data
+// payload.
+func fmtSSEEvent(w http.ResponseWriter, event string, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	w.Write([]byte("event: " + event + "\n"))
+	w.Write([]byte("data: "))
+	w.Write(data)
+	w.Write([]byte("\n\n"))
+}
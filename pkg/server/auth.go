@@ -0,0 +1,122 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Authenticator decides whether an incoming request is allowed to reach
+// the dashboard/API. It's intentionally separate from
+// nova_ext/auth.AuthProvider, which authenticates the engine's own
+// *outgoing* requests to Nova — this authenticates requests coming in to
+// this server.
+type Authenticator interface {
+	Authenticate(r *http.Request) bool
+}
+
+// BearerToken authenticates requests carrying "Authorization: Bearer
+// <token>" matching the configured token.
+type BearerToken struct {
+	token string
+}
+
+// NewBearerToken returns an Authenticator requiring the given static
+// bearer token.
+func NewBearerToken(token string) BearerToken {
+	return BearerToken{token: token}
+}
+
+// Authenticate implements Authenticator. It accepts the token either as
+// "Authorization: Bearer <token>" or as an "access_token" query parameter,
+// since browser EventSource requests (used for the live state stream)
+// cannot set custom headers.
+func (b BearerToken) Authenticate(r *http.Request) bool {
+	const prefix = "Bearer "
+	presented := r.URL.Query().Get("access_token")
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, prefix) {
+		presented = strings.TrimPrefix(header, prefix)
+	}
+	if presented == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(b.token)) == 1
+}
+
+// SessionCookie authenticates requests carrying a cookie whose value is an
+// HMAC-SHA256 of the session name, keyed by secret — a minimal signed
+// session, not a full session store.
+type SessionCookie struct {
+	name   string
+	secret []byte
+}
+
+// NewSessionCookie returns an Authenticator that accepts a cookie named
+// name whose value is Sign's output.
+func NewSessionCookie(name string, secret []byte) SessionCookie {
+	return SessionCookie{name: name, secret: secret}
+}
+
+// Sign produces the hex-encoded cookie value a client must present to
+// authenticate.
+func (s SessionCookie) Sign() string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(s.name))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticate implements Authenticator.
+func (s SessionCookie) Authenticate(r *http.Request) bool {
+	cookie, err := r.Cookie(s.name)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(cookie.Value), []byte(s.Sign()))
+}
+
+// cookie builds the http.Cookie a client must store to satisfy Authenticate.
+func (s SessionCookie) cookie() *http.Cookie {
+	return &http.Cookie{
+		Name:     s.name,
+		Value:    s.Sign(),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+// Any succeeds if any of its Authenticators succeeds, letting a server
+// accept both a bearer token and a session cookie as the request asks for.
+type Any []Authenticator
+
+// Authenticate implements Authenticator.
+func (a Any) Authenticate(r *http.Request) bool {
+	for _, auth := range a {
+		if auth.Authenticate(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// requireAuth wraps fn so it's only reached when s.auth is nil (auth
+// disabled) or authenticates the request. When WithLogin is configured and
+// the request authenticated via bearer rather than the session cookie
+// already present, it also issues the session cookie, so later
+// cookie-only requests (in particular EventSource, which can't carry a
+// bearer token) stay authenticated.
+func (s *Server) requireAuth(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.auth != nil && !s.auth.Authenticate(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if s.login != nil && !s.login.cookie.Authenticate(r) && s.login.bearer.Authenticate(r) {
+			http.SetCookie(w, s.login.cookie.cookie())
+		}
+		fn(w, r)
+	}
+}
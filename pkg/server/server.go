@@ -0,0 +1,156 @@
+// Package server exposes the robot abstraction over HTTP: a JSON REST API
+// plus an SSE live-state endpoint for operator tooling, and an HTML
+// dashboard rendered from embedded templates for single-binary
+// deployment. It depends only on robot.Backend, so it works identically
+// against a real Nova cell or the in-process simulator.
+package server
+
+import (
+	"embed"
+	"html/template"
+	"net/http"
+	"sort"
+
+	"github.com/ardo314/engine/pkg/robot"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Server serves the cell/controller/motion-group dashboard for a fixed set
+// of named backends ("cells" in operator-facing terms — one robot.Backend
+// per named deployment the operator wants to inspect).
+type Server struct {
+	cells map[string]robot.Backend
+	auth  Authenticator
+	login *loginConfig
+
+	templates *template.Template
+	mux       *http.ServeMux
+}
+
+// loginConfig holds the bearer/cookie pair configured by WithLogin: bearer
+// is what a client must present to log in, cookie is what's issued (and
+// accepted thereafter) once it does.
+type loginConfig struct {
+	bearer BearerToken
+	cookie SessionCookie
+}
+
+// Option configures a Server returned by New.
+type Option func(*Server)
+
+// WithAuthenticator protects every route behind auth. Without this option,
+// the server is unprotected — suitable only for local/simulator use.
+func WithAuthenticator(auth Authenticator) Option {
+	return func(s *Server) { s.auth = auth }
+}
+
+// WithLogin protects every route behind bearer or cookie, and adds a
+// POST /login endpoint that exchanges a valid bearer credential for the
+// session cookie. This exists because a browser's EventSource (used by the
+// live state stream) can't send an Authorization header, so a bearer-only
+// Authenticator leaves the dashboard's live view unusable: visiting a page
+// once with the bearer token (e.g. "?access_token=...") logs in and leaves
+// the browser with a cookie that EventSource sends automatically from then
+// on.
+func WithLogin(bearer BearerToken, cookie SessionCookie) Option {
+	return func(s *Server) {
+		s.login = &loginConfig{bearer: bearer, cookie: cookie}
+		s.auth = Any{bearer, cookie}
+	}
+}
+
+// New builds a Server for the given named backends.
+func New(cells map[string]robot.Backend, opts ...Option) (*Server, error) {
+	tmpl, err := template.ParseFS(templateFS, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{cells: cells, templates: tmpl}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.mux = http.NewServeMux()
+	s.routes()
+	return s, nil
+}
+
+func (s *Server) routes() {
+	s.handle("GET /", s.handleIndex)
+	s.handle("GET /cells", s.handleListCells)
+	s.handle("GET /cells/{cell}/controllers", s.handleListControllers)
+	s.handle("GET /cells/{cell}/controllers/{ctrl}/motion-groups", s.handleListMotionGroups)
+	s.handle("GET /cells/{cell}/controllers/{ctrl}/motion-groups/{mg}", s.handleMotionGroupPage)
+	s.handle("GET /cells/{cell}/controllers/{ctrl}/motion-groups/{mg}/state", s.handleState)
+	s.handle("GET /cells/{cell}/controllers/{ctrl}/motion-groups/{mg}/state/stream", s.handleStateStream)
+	if s.login != nil {
+		s.mux.HandleFunc("POST /login", s.handleLogin)
+	}
+}
+
+// handle registers pattern behind the server's authenticator, if any. When
+// WithLogin is configured and the request authenticates via bearer rather
+// than the session cookie, it also issues the cookie, so a single
+// bearer-authenticated request (e.g. the dashboard's first page load) is
+// enough to carry the browser through every later cookie-only request,
+// including EventSource.
+func (s *Server) handle(pattern string, fn http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, s.requireAuth(fn))
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// cellNames returns the server's configured cell names, sorted for
+// deterministic listing output.
+func (s *Server) cellNames() []string {
+	names := make([]string, 0, len(s.cells))
+	for name := range s.cells {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) cell(w http.ResponseWriter, r *http.Request) (robot.Backend, bool) {
+	name := r.PathValue("cell")
+	backend, ok := s.cells[name]
+	if !ok {
+		http.Error(w, "unknown cell "+name, http.StatusNotFound)
+		return nil, false
+	}
+	return backend, true
+}
+
+func (s *Server) controller(w http.ResponseWriter, r *http.Request) (robot.Controller, bool) {
+	backend, ok := s.cell(w, r)
+	if !ok {
+		return nil, false
+	}
+	return backend.Controller(r.PathValue("ctrl")), true
+}
+
+func (s *Server) motionGroup(w http.ResponseWriter, r *http.Request) (robot.MotionGroup, bool) {
+	ctrl, ok := s.controller(w, r)
+	if !ok {
+		return nil, false
+	}
+	groups, err := ctrl.MotionGroups(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return nil, false
+	}
+	name := r.PathValue("mg")
+	for _, mg := range groups {
+		if mg.Name() == name {
+			return mg, true
+		}
+	}
+	http.Error(w, "unknown motion group "+name, http.StatusNotFound)
+	return nil, false
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// acceptsHTML reports whether r prefers an HTML response, e.g. a browser
+// navigating the dashboard rather than a script calling the JSON API.
+func acceptsHTML(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+type indexPageData struct {
+	Cells []string
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	data := indexPageData{Cells: s.cellNames()}
+	if err := s.templates.ExecuteTemplate(w, "index.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type controllersPageData struct {
+	Cell        string
+	Controllers []string
+}
+
+func (s *Server) renderControllerList(w http.ResponseWriter, r *http.Request, names []string) {
+	data := controllersPageData{Cell: r.PathValue("cell"), Controllers: names}
+	if err := s.templates.ExecuteTemplate(w, "controllers.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type motionGroupsPageData struct {
+	Cell         string
+	Controller   string
+	MotionGroups []string
+}
+
+func (s *Server) renderMotionGroupList(w http.ResponseWriter, r *http.Request, names []string) {
+	data := motionGroupsPageData{
+		Cell:         r.PathValue("cell"),
+		Controller:   r.PathValue("ctrl"),
+		MotionGroups: names,
+	}
+	if err := s.templates.ExecuteTemplate(w, "motion_groups.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type motionGroupPageData struct {
+	Cell        string
+	Controller  string
+	MotionGroup string
+}
+
+func (s *Server) handleMotionGroupPage(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.motionGroup(w, r); !ok {
+		return
+	}
+	data := motionGroupPageData{
+		Cell:        r.PathValue("cell"),
+		Controller:  r.PathValue("ctrl"),
+		MotionGroup: r.PathValue("mg"),
+	}
+	if err := s.templates.ExecuteTemplate(w, "motion_group.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}